@@ -1,7 +1,82 @@
 package main
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+)
+
+// Build information, set at build time via
+// -ldflags "-X main.version=... -X main.commit=... -X main.buildTime=...".
+// When the flags are absent, version and commit fall back to the module
+// and VCS data embedded by the Go toolchain; buildTime stays unknown.
+var (
+	version   = "dev"
+	commit    = "unknown"
+	buildTime = "unknown"
+)
+
+func init() {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		applyBuildInfo(info.Main.Version, info.Settings)
+	}
+}
+
+// applyBuildInfo fills version and commit from embedded build info, leaving
+// values set via ldflags untouched.
+func applyBuildInfo(mainVersion string, settings []debug.BuildSetting) {
+	if version == "dev" && mainVersion != "" && mainVersion != "(devel)" {
+		version = mainVersion
+	}
+	if commit != "unknown" {
+		return
+	}
+	var revision string
+	var modified bool
+	for _, s := range settings {
+		switch s.Key {
+		case "vcs.revision":
+			revision = s.Value
+		case "vcs.modified":
+			modified = s.Value == "true"
+		}
+	}
+	if revision == "" {
+		return
+	}
+	commit = revision
+	if modified {
+		commit += "-dirty"
+	}
+}
+
+func versionString() string {
+	return fmt.Sprintf("go_eth_study %s (commit %s, built %s)", version, commit, buildTime)
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintf(w, "usage: %s [version]\n", filepath.Base(os.Args[0]))
+}
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "version":
+			fmt.Println(versionString())
+			return
+		case "help", "-h", "--help":
+			usage(os.Stdout)
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "unknown command %q\n", os.Args[1])
+			usage(os.Stderr)
+			os.Exit(2)
+		}
+	}
+
+	log.Printf("starting %s", versionString())
 	fmt.Println("hello world")
 }
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"runtime/debug"
+	"testing"
+)
+
+func setBuildVars(t *testing.T, v, c, b string) {
+	t.Helper()
+	oldV, oldC, oldB := version, commit, buildTime
+	t.Cleanup(func() { version, commit, buildTime = oldV, oldC, oldB })
+	version, commit, buildTime = v, c, b
+}
+
+func TestVersionString(t *testing.T) {
+	setBuildVars(t, "v1.2.3", "abc123", "2026-01-02T03:04:05Z")
+	want := "go_eth_study v1.2.3 (commit abc123, built 2026-01-02T03:04:05Z)"
+	if got := versionString(); got != want {
+		t.Fatalf("versionString() = %q, want %q", got, want)
+	}
+}
+
+func TestApplyBuildInfo(t *testing.T) {
+	const rev = "0123456789abcdef0123456789abcdef01234567"
+	clean := []debug.BuildSetting{
+		{Key: "vcs.revision", Value: rev},
+		{Key: "vcs.time", Value: "2026-01-02T03:04:05Z"},
+		{Key: "vcs.modified", Value: "false"},
+	}
+	dirty := []debug.BuildSetting{
+		{Key: "vcs.revision", Value: rev},
+		{Key: "vcs.modified", Value: "true"},
+	}
+
+	tests := []struct {
+		name                    string
+		version, commit         string
+		mainVersion             string
+		settings                []debug.BuildSetting
+		wantVersion, wantCommit string
+	}{
+		{"defaults from vcs", "dev", "unknown", "(devel)", clean, "dev", rev},
+		{"dirty tree", "dev", "unknown", "(devel)", dirty, "dev", rev + "-dirty"},
+		{"module version", "dev", "unknown", "v0.4.0", nil, "v0.4.0", "unknown"},
+		{"ldflags win", "v1.0.0", "abc123", "v0.4.0", dirty, "v1.0.0", "abc123"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setBuildVars(t, tt.version, tt.commit, "unknown")
+			applyBuildInfo(tt.mainVersion, tt.settings)
+			if version != tt.wantVersion || commit != tt.wantCommit {
+				t.Fatalf("got version %q commit %q, want %q %q", version, commit, tt.wantVersion, tt.wantCommit)
+			}
+			if buildTime != "unknown" {
+				t.Fatalf("buildTime = %q, want unknown", buildTime)
+			}
+		})
+	}
+}